@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/atotto/clipboard"
+)
+
+// copyKeybind copies row's Keybind to the system clipboard ('y').
+func copyKeybind(row rowConfig) error {
+	return clipboard.WriteAll(row.Keybind)
+}
+
+// formattedCopyLine renders row as "mode  keybind → action", the line
+// copyFormattedLine ('Y') copies.
+func formattedCopyLine(row rowConfig) string {
+	return fmt.Sprintf("%s  %s → %s", row.Mode, row.Keybind, row.Action)
+}
+
+// copyFormattedLine copies formattedCopyLine(row) to the system clipboard.
+func copyFormattedLine(row rowConfig) error {
+	return clipboard.WriteAll(formattedCopyLine(row))
+}
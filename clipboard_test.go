@@ -0,0 +1,13 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormattedCopyLine(t *testing.T) {
+	row := rowConfig{Mode: "normal", Keybind: "gd", Action: "go to definition"}
+
+	require.Equal(t, "normal  gd → go to definition", formattedCopyLine(row))
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Scoring constants loosely modelled on fzf's matching algorithm: an exact
+// character match is worth scoreMatch, runs of consecutive matches and
+// matches that land on a word boundary earn bonuses, and gaps between
+// matched characters are penalised the longer they run.
+const (
+	fuzzyScoreMatch        = 16
+	fuzzyScoreGapStart     = -3
+	fuzzyScoreGapExtension = -1
+	fuzzyBonusBoundary     = 8
+	fuzzyBonusFirstChar    = fuzzyBonusBoundary * 2
+	fuzzyBonusConsecutive  = 4
+
+	negInf = -1 << 30
+)
+
+var fuzzyMatchStyle = lipgloss.NewStyle().Bold(true).Reverse(true)
+
+func isFuzzyBoundarySeparator(r rune) bool {
+	switch r {
+	case ' ', '-', '_', '/', '.', ':', '<', '>', ',', '(', ')', '"':
+		return true
+	}
+	return false
+}
+
+func isFuzzyBoundary(prev, cur rune) bool {
+	if isFuzzyBoundarySeparator(prev) {
+		return true
+	}
+	return unicode.IsLower(prev) && unicode.IsUpper(cur)
+}
+
+func fuzzyBonusAt(text []rune, pos int) int {
+	if pos == 0 {
+		return fuzzyBonusFirstChar
+	}
+	if isFuzzyBoundary(text[pos-1], text[pos]) {
+		return fuzzyBonusBoundary
+	}
+	return 0
+}
+
+func fuzzyGapPenalty(gap int) int {
+	if gap <= 0 {
+		return 0
+	}
+	return fuzzyScoreGapStart + fuzzyScoreGapExtension*(gap-1)
+}
+
+// fuzzyMatch walks query left-to-right over text, requiring characters to
+// appear in order (not necessarily contiguously), and returns the best
+// possible score along with the rune offsets into text that were matched.
+// An empty query trivially matches everything with a score of 0. ok is
+// false when text does not contain every query character in order.
+func fuzzyMatch(query, text string) (score int, offsets []int, ok bool) {
+	queryRunes := []rune(strings.ToLower(query))
+	if len(queryRunes) == 0 {
+		return 0, nil, true
+	}
+
+	textRunes := []rune(text)
+	textLower := []rune(strings.ToLower(text))
+
+	n, m := len(queryRunes), len(textRunes)
+	if n > m {
+		return 0, nil, false
+	}
+
+	dp := make([][]int, n)
+	back := make([][]int, n)
+	for i := range dp {
+		dp[i] = make([]int, m)
+		back[i] = make([]int, m)
+		for j := range dp[i] {
+			dp[i][j] = negInf
+			back[i][j] = -2
+		}
+	}
+
+	for j := 0; j < m; j++ {
+		if textLower[j] != queryRunes[0] {
+			continue
+		}
+		dp[0][j] = fuzzyScoreMatch + fuzzyBonusAt(textRunes, j) + fuzzyGapPenalty(j)
+		back[0][j] = -1
+	}
+
+	for i := 1; i < n; i++ {
+		for j := i; j < m; j++ {
+			if textLower[j] != queryRunes[i] {
+				continue
+			}
+
+			best, bestPrev := negInf, -2
+			for jp := i - 1; jp < j; jp++ {
+				if dp[i-1][jp] == negInf {
+					continue
+				}
+
+				gap := j - jp - 1
+				bonus := fuzzyBonusConsecutive
+				if gap > 0 {
+					bonus = fuzzyGapPenalty(gap)
+				}
+
+				if cand := dp[i-1][jp] + bonus; cand > best {
+					best, bestPrev = cand, jp
+				}
+			}
+
+			if best == negInf {
+				continue
+			}
+			dp[i][j] = best + fuzzyScoreMatch + fuzzyBonusAt(textRunes, j)
+			back[i][j] = bestPrev
+		}
+	}
+
+	bestScore, bestJ := negInf, -1
+	for j, s := range dp[n-1] {
+		if s > bestScore {
+			bestScore, bestJ = s, j
+		}
+	}
+	if bestJ == -1 {
+		return 0, nil, false
+	}
+
+	offsets = make([]int, n)
+	for i, j := n-1, bestJ; i >= 0; i-- {
+		offsets[i] = j
+		j = back[i][j]
+	}
+
+	return bestScore, offsets, true
+}
+
+// highlightFuzzyMatches wraps the runes of text at the given offsets in
+// fuzzyMatchStyle so matched characters stand out in the table.
+func highlightFuzzyMatches(text string, offsets []int) string {
+	if len(offsets) == 0 {
+		return text
+	}
+
+	matched := make(map[int]bool, len(offsets))
+	for _, o := range offsets {
+		matched[o] = true
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(text) {
+		if matched[i] {
+			b.WriteString(fuzzyMatchStyle.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// fuzzyRanked is one surviving row from fuzzyRank: its position in the
+// input slice, its highlighted contents, and the score it was ranked by.
+type fuzzyRanked struct {
+	index int
+	row   table.Row
+	score int
+}
+
+// fuzzyRank scores every row against query across all of its columns,
+// keeps only rows with at least one column scoring above zero, highlights
+// the matched runes in those columns, and sorts by descending score
+// (original order on ties). index is the row's position in the input
+// slice, so callers can map results back to their own bookkeeping.
+func fuzzyRank(rows []table.Row, query string) []fuzzyRanked {
+	results := make([]fuzzyRanked, 0, len(rows))
+
+	for i, row := range rows {
+		highlighted := append(table.Row(nil), row...)
+		bestScore := 0
+		matched := false
+
+		for col, text := range row {
+			score, offsets, ok := fuzzyMatch(query, text)
+			if !ok || score <= 0 {
+				continue
+			}
+
+			matched = true
+			if score > bestScore {
+				bestScore = score
+			}
+			highlighted[col] = highlightFuzzyMatches(text, offsets)
+		}
+
+		if !matched {
+			continue
+		}
+
+		results = append(results, fuzzyRanked{index: i, row: highlighted, score: bestScore})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score > results[j].score
+	})
+
+	return results
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuzzyMatch_EmptyQueryAlwaysMatchesWithZeroScore(t *testing.T) {
+	score, offsets, ok := fuzzyMatch("", "go to definition")
+	require.True(t, ok)
+	require.Equal(t, 0, score)
+	require.Nil(t, offsets)
+}
+
+func TestFuzzyMatch_RequiresCharactersInOrder(t *testing.T) {
+	_, _, ok := fuzzyMatch("gd", "grr")
+	require.False(t, ok, "grr has no 'd' so gd should not match")
+}
+
+func TestFuzzyMatch_RewardsWordBoundaryOverMidWordMatch(t *testing.T) {
+	boundaryScore, _, ok := fuzzyMatch("d", "go to definition")
+	require.True(t, ok)
+
+	midWordScore, _, ok := fuzzyMatch("d", "paddle")
+	require.True(t, ok)
+
+	require.Greater(t, boundaryScore, midWordScore)
+}
+
+func TestFuzzyMatch_RewardsConsecutiveRunsOverScatteredMatches(t *testing.T) {
+	consecutive, _, ok := fuzzyMatch("def", "definition")
+	require.True(t, ok)
+
+	scattered, _, ok := fuzzyMatch("def", "delete everything first")
+	require.True(t, ok)
+
+	require.Greater(t, consecutive, scattered)
+}
+
+func TestFuzzyMatch_OffsetsPointAtMatchedRunes(t *testing.T) {
+	_, offsets, ok := fuzzyMatch("gd", "go to definition (LSP if attached)")
+	require.True(t, ok)
+	require.Equal(t, []int{0, 6}, offsets)
+}
+
+func TestFuzzyRank_OrdersByScoreDescendingAndDropsNonMatches(t *testing.T) {
+	rows := []table.Row{
+		{"normal", "gd", "go to definition (LSP if attached)"},
+		{"normal", "grr", "show references (LSP)"},
+		{"normal", "K", "hover documentation (LSP or man page)"},
+	}
+
+	ranked := fuzzyRank(rows, "gd")
+
+	require.Len(t, ranked, 1)
+	require.Equal(t, 0, ranked[0].index)
+	require.Equal(t, "gd", stripANSI(ranked[0].row[1]))
+}
+
+func TestFuzzyRank_BreaksTiesByOriginalOrder(t *testing.T) {
+	rows := []table.Row{
+		{"normal", "dw", "delete from cursor to start of next word"},
+		{"normal", "db", "delete from cursor to start of previous word"},
+	}
+
+	ranked := fuzzyRank(rows, "d")
+
+	require.Len(t, ranked, 2)
+	require.Equal(t, "dw", stripANSI(ranked[0].row[1]))
+	require.Equal(t, "db", stripANSI(ranked[1].row[1]))
+}
+
+// stripANSI removes lipgloss-rendered escape sequences so highlighted cells
+// can be compared against their plain text.
+func stripANSI(s string) string {
+	var out []rune
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case r == '\x1b':
+			inEscape = true
+		case inEscape && r == 'm':
+			inEscape = false
+		case !inEscape:
+			out = append(out, r)
+		}
+	}
+	return string(out)
+}
@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/JNickson/nvim-simple-keybind-helper/nvimparse"
 )
 
 var baseStyle = lipgloss.NewStyle().
@@ -25,11 +30,85 @@ var searchStyle = lipgloss.NewStyle().
 var helpStyle = lipgloss.NewStyle().
 	Foreground(lipgloss.Color("241"))
 
+var sectionHeaderStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("212")).
+	Bold(true)
+
+var dimStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("241"))
+
+var selectedRowStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("229")).
+	Background(lipgloss.Color("57"))
+
+var scrollbarThumbStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("240"))
+
+var scrollbarTrackStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("236"))
+
 type model struct {
-	table      table.Model
-	allRows    []table.Row
+	viewport viewport.Model
+	columns  []table.Column
+	allRows  []table.Row
+
+	// modeOrder is the fixed section order (first-seen Mode values from
+	// cfg.Rows), independent of the current filter or fuzzy ranking.
+	// sections is the current Mode-grouped, filtered view built by
+	// filterRows; collapsed tracks which of its sections are folded
+	// ('zc'/'zo'/'za'/'zM'/'zR'). cursor indexes into entries, the flat,
+	// display-order list of section headers and (for expanded sections)
+	// their rows; headers stay reachable by cursor so a collapsed
+	// section can still be found and reopened.
+	modeOrder   []string
+	sections    []section
+	collapsed   foldState
+	cursor      int
+	entries     []displayEntry
+	pendingFold bool
+
 	search     textinput.Model
 	searchMode bool
+	fuzzy      bool
+
+	// showSource is true when rows carry an implicit Source column as
+	// their last element (set when loaded from one or more --config
+	// files). sources lists the distinct source names in first-seen
+	// order; sourceFilter is "" for "all" or one of sources.
+	showSource   bool
+	sources      []string
+	sourceFilter string
+
+	// cfg is the live, editable config backing allRows.
+	cfg appConfig
+
+	// configPath is where 'w' writes cfg, resolved at startup from a
+	// single --config path. It's empty when the tool started with no
+	// config, multiple --config files, or --import, in which case 'w'
+	// falls back to savePrompt to ask where to write.
+	configPath string
+
+	editing    bool
+	editFields [3]textinput.Model
+	editFocus  int
+	editIndex  int // index into cfg.Rows being edited; -1 means append a new row
+
+	confirmDelete bool
+
+	savePrompt bool
+	saveInput  textinput.Model
+
+	// statusMsg is a transient message shown above the help footer (e.g.
+	// "Copied gd" or a save/clipboard/Neovim error); statusMsgID is bumped
+	// every time it's set so a stale tea.Tick from an earlier message
+	// can't clear a newer one out from under it.
+	statusMsg   string
+	statusMsgID int
+
+	// nvimSocket is the msgpack-RPC unix socket 'x' sends keys to,
+	// resolved at startup from --nvim-socket or $NVIM; "" means 'x' has
+	// nothing to connect to.
+	nvimSocket string
 }
 
 type columnConfig struct {
@@ -41,38 +120,450 @@ type rowConfig struct {
 	Mode    string `json:"mode"`
 	Keybind string `json:"keybind"`
 	Action  string `json:"action"`
+	// Remove deletes an inherited row with the same (Mode, Keybind) when
+	// this row is merged in from a later --config file.
+	Remove bool `json:"remove,omitempty"`
+	// Source is the name of the config file this row came from, derived
+	// at load time; it is never read from or written back to JSON.
+	Source string `json:"-"`
 }
 
 type appConfig struct {
 	Columns []columnConfig `json:"columns"`
 	Rows    []rowConfig    `json:"rows"`
 	Height  int            `json:"height"`
+	// HideSourceColumn suppresses the implicit Source column that's added
+	// when rows were merged from multiple --config files.
+	HideSourceColumn bool `json:"hideSource,omitempty"`
 }
 
 func (m model) Init() tea.Cmd {
 	return nil
 }
 
-func (m *model) filterRows(query string) {
-	query = strings.ToLower(query)
+// sourceFilteredIndices returns the indices into m.allRows restricted to
+// the currently selected source (the last column), or every index when
+// the filter is "all" (sourceFilter == "") or there's no Source column
+// to filter by.
+func (m *model) sourceFilteredIndices() []int {
+	if !m.showSource || m.sourceFilter == "" {
+		indices := make([]int, len(m.allRows))
+		for i := range m.allRows {
+			indices[i] = i
+		}
+		return indices
+	}
 
-	if query == "" {
-		m.table.SetRows(m.allRows)
+	var indices []int
+	for i, row := range m.allRows {
+		if row[len(row)-1] == m.sourceFilter {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// cycleSourceFilter advances sourceFilter through {all, sources[0], ...}.
+func (m *model) cycleSourceFilter() {
+	if !m.showSource || len(m.sources) == 0 {
+		return
+	}
+
+	options := append([]string{""}, m.sources...)
+	current := 0
+	for i, opt := range options {
+		if opt == m.sourceFilter {
+			current = i
+			break
+		}
+	}
+
+	m.sourceFilter = options[(current+1)%len(options)]
+}
+
+// rebuildRows regenerates allRows and modeOrder from cfg.Rows after an
+// add, edit or delete, then reapplies the current search/source filter
+// on top of it.
+func (m *model) rebuildRows() {
+	rows := configRowsToTableRows(m.cfg.Rows)
+	if m.showSource {
+		rows = withSourceColumn(rows, m.cfg.Rows)
+	}
+
+	m.allRows = rows
+	m.modeOrder = distinctModes(m.cfg.Rows)
+	m.filterRows(m.search.Value())
+}
+
+// beginEditForm switches into edit mode with one textinput.Model per
+// field of row, focused on the first.
+func (m *model) beginEditForm(row rowConfig) {
+	values := [3]string{row.Mode, row.Keybind, row.Action}
+
+	for i, value := range values {
+		ti := textinput.New()
+		ti.SetValue(value)
+		m.editFields[i] = ti
+	}
+
+	m.editFocus = 0
+	m.editFields[0].Focus()
+	m.editing = true
+}
+
+// startAdd enters edit mode for a brand-new row, appended on commit.
+func (m *model) startAdd() {
+	m.editIndex = -1
+	m.beginEditForm(rowConfig{})
+}
+
+// startEdit enters edit mode for the row currently under the cursor.
+// It's a no-op when the table is empty or the cursor is on a section
+// header rather than a row.
+func (m *model) startEdit() {
+	idx, ok := m.currentRowIndex()
+	if !ok {
 		return
 	}
 
+	m.editIndex = idx
+	m.beginEditForm(m.cfg.Rows[m.editIndex])
+}
+
+// commitEdit reads the edit form's fields back into a rowConfig, keeping
+// the Source and Remove of the row being replaced (if any) so editing a
+// row's text doesn't disturb its provenance.
+func (m *model) commitEdit() rowConfig {
+	row := rowConfig{}
+	if m.editIndex >= 0 && m.editIndex < len(m.cfg.Rows) {
+		row = m.cfg.Rows[m.editIndex]
+	}
+
+	row.Mode = m.editFields[0].Value()
+	row.Keybind = m.editFields[1].Value()
+	row.Action = m.editFields[2].Value()
+
+	return row
+}
+
+// startSave writes cfg to configPath, or, when no single config path is
+// known (no --config was given, several were layered, or the tool was
+// started with --import), opens savePrompt to ask where to write it.
+func (m *model) startSave() {
+	if m.configPath != "" {
+		if err := saveConfig(m.cfg, m.configPath); err != nil {
+			m.setStatusMsg("Error saving: " + err.Error())
+			return
+		}
+		m.setStatusMsg("Saved to " + m.configPath)
+		return
+	}
+
+	m.saveInput = textinput.New()
+	m.saveInput.Placeholder = "path/to/config.json"
+	m.saveInput.Width = 40
+	m.saveInput.Focus()
+	m.savePrompt = true
+}
+
+// statusMsgTimeout is how long a transient status line set via setStatus
+// stays on screen before it's cleared.
+const statusMsgTimeout = 2 * time.Second
+
+// clearStatusMsg is sent by the tea.Tick setStatus schedules; it carries
+// the id of the statusMsg it's clearing so a later message overwriting
+// statusMsg in the meantime isn't wiped out by a stale tick.
+type clearStatusMsg struct{ id int }
+
+// setStatusMsg shows msg above the help footer and bumps statusMsgID, so
+// that any clearStatusMsg tick already in flight from an earlier
+// setStatus call no longer matches and can't clear it out from under a
+// message that's meant to persist (e.g. a save confirmation).
+func (m *model) setStatusMsg(msg string) {
+	m.statusMsg = msg
+	m.statusMsgID++
+}
+
+// setStatus shows msg above the help footer and schedules it to clear
+// after statusMsgTimeout, for transient feedback like a clipboard copy
+// or a Neovim send that shouldn't linger like a save confirmation does.
+func (m *model) setStatus(msg string) tea.Cmd {
+	m.setStatusMsg(msg)
+	id := m.statusMsgID
+
+	return tea.Tick(statusMsgTimeout, func(time.Time) tea.Msg {
+		return clearStatusMsg{id: id}
+	})
+}
+
+// nvimSendResultMsg reports the outcome of a sendToNvimCmd's 'x' send.
+type nvimSendResultMsg struct{ err error }
+
+// sendToNvimCmd sends keys to socket over msgpack-RPC, off the Update
+// goroutine, and reports the outcome back as a nvimSendResultMsg.
+func sendToNvimCmd(socket, keys string) tea.Cmd {
+	return func() tea.Msg {
+		return nvimSendResultMsg{err: sendKeys(socket, keys)}
+	}
+}
+
+// filteredRows applies the current source filter, search query and fuzzy
+// mode to allRows, returning the surviving rows in display order along
+// with the cfg.Rows index each one came from.
+func (m *model) filteredRows(query string) ([]table.Row, []int) {
+	indices := m.sourceFilteredIndices()
+
+	rows := make([]table.Row, len(indices))
+	for i, idx := range indices {
+		rows[i] = m.allRows[idx]
+	}
+
+	if query == "" {
+		return rows, indices
+	}
+
+	if m.fuzzy {
+		ranked := fuzzyRank(rows, query)
+		filtered := make([]table.Row, len(ranked))
+		rowIndex := make([]int, len(ranked))
+		for i, r := range ranked {
+			filtered[i] = r.row
+			rowIndex[i] = indices[r.index]
+		}
+		return filtered, rowIndex
+	}
+
+	lowerQuery := strings.ToLower(query)
+
 	var filtered []table.Row
+	var rowIndex []int
 
-	for _, row := range m.allRows {
+	for i, row := range rows {
 		for _, col := range row {
-			if strings.Contains(strings.ToLower(col), query) {
+			if strings.Contains(strings.ToLower(col), lowerQuery) {
 				filtered = append(filtered, row)
+				rowIndex = append(rowIndex, indices[i])
 				break // stop checking this row once matched
 			}
 		}
 	}
 
-	m.table.SetRows(filtered)
+	return filtered, rowIndex
+}
+
+// filterRows recomputes the Mode-grouped sections from allRows for the
+// current source filter, search query and fuzzy mode, then refreshes the
+// viewport. entries is rebuilt to cover every section header plus the
+// rows of expanded sections, so the cursor can always reach a collapsed
+// section's header to reopen it.
+func (m *model) filterRows(query string) {
+	rows, indices := m.filteredRows(query)
+	m.sections = groupRowsByMode(m.modeOrder, rows, indices)
+	m.entries = buildDisplayEntries(m.sections, m.collapsed)
+
+	m.cursor = clampInt(m.cursor, 0, len(m.entries)-1)
+	m.refreshViewport()
+}
+
+// clampInt constrains n to [low, high], treating high < low as an empty
+// range clamped to low (e.g. when there are no rows at all).
+func clampInt(n, low, high int) int {
+	if high < low {
+		return low
+	}
+	if n < low {
+		return low
+	}
+	if n > high {
+		return high
+	}
+	return n
+}
+
+// currentMode returns the Mode of the section under the cursor (whether
+// the cursor is on its header or one of its rows), and false if there's
+// nothing visible to report one for.
+func (m *model) currentMode() (string, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.entries) {
+		return "", false
+	}
+
+	return m.entries[m.cursor].mode, true
+}
+
+// currentRowIndex returns the cfg.Rows index of the row under the
+// cursor, and false when the cursor is on a section header or there's
+// nothing visible.
+func (m *model) currentRowIndex() (int, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.entries) {
+		return 0, false
+	}
+
+	idx := m.entries[m.cursor].rowIdx
+	if idx < 0 {
+		return 0, false
+	}
+
+	return idx, true
+}
+
+// moveCursor shifts the cursor by delta entries, clamped to the visible
+// range, and scrolls the viewport to keep it on screen.
+func (m *model) moveCursor(delta int) {
+	if len(m.entries) == 0 {
+		return
+	}
+
+	m.cursor = clampInt(m.cursor+delta, 0, len(m.entries)-1)
+	m.refreshViewport()
+}
+
+// padCell pads s with spaces to width, measuring with lipgloss.Width so
+// ANSI-styled cells (e.g. fuzzy match highlights) still line up.
+func padCell(s string, width int) string {
+	if pad := width - lipgloss.Width(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
+
+// renderRow lays row out across m.columns, padding each cell to its
+// configured width, and applies selectedRowStyle when this is the row
+// under the cursor.
+func (m *model) renderRow(row table.Row, selected bool) string {
+	cells := make([]string, len(row))
+	for i, col := range row {
+		width := 0
+		if i < len(m.columns) {
+			width = m.columns[i].Width
+		}
+		cells[i] = padCell(col, width)
+	}
+
+	line := strings.Join(cells, " ")
+	if selected {
+		return selectedRowStyle.Render(line)
+	}
+	return line
+}
+
+// sectionHeaderLine renders a fold marker, title-cased mode name and a
+// dimmed row count, e.g. "▾ Normal (23)". When selected (the cursor is
+// on this header, reachable even while collapsed so it can be reopened),
+// it's rendered in selectedRowStyle instead.
+func sectionHeaderLine(mode string, count int, collapsed, selected bool) string {
+	marker := "▾"
+	if collapsed {
+		marker = "▸"
+	}
+
+	plain := fmt.Sprintf("%s %s (%d)", marker, titleCaseMode(mode), count)
+	if selected {
+		return selectedRowStyle.Render(plain)
+	}
+
+	return sectionHeaderStyle.Render(marker+" "+titleCaseMode(mode)) +
+		" " + dimStyle.Render(fmt.Sprintf("(%d)", count))
+}
+
+// renderScrollbar returns a height-tall rail of scrollbarThumbStyle runes
+// marking the thumb position implied by percent (0 at top, 1 at bottom)
+// over a scrollbarTrackStyle background.
+func renderScrollbar(height int, percent float64) []string {
+	if height <= 0 {
+		return nil
+	}
+
+	thumbSize := max(1, height/4)
+	thumbStart := clampInt(int(percent*float64(height-thumbSize)), 0, height-thumbSize)
+
+	rail := make([]string, height)
+	for i := range rail {
+		if i >= thumbStart && i < thumbStart+thumbSize {
+			rail[i] = scrollbarThumbStyle.Render("█")
+		} else {
+			rail[i] = scrollbarTrackStyle.Render("│")
+		}
+	}
+	return rail
+}
+
+// totalColumnsWidth sums column widths plus the single space joining
+// each pair of adjacent columns.
+func totalColumnsWidth(columns []table.Column) int {
+	width := 0
+	for i, col := range columns {
+		if i > 0 {
+			width++
+		}
+		width += col.Width
+	}
+	return width
+}
+
+// renderContent builds the full Mode-grouped, collapsible text content
+// for the viewport: a header + rows per section (rows omitted while
+// collapsed), and returns alongside it the line number the cursor is on
+// so the caller can scroll it into view. entryIdx walks m.entries in
+// lockstep with the headers and rows being rendered, so the cursor can
+// land on a header (collapsed or not) as well as on a row.
+func (m *model) renderContent() (string, int) {
+	var b strings.Builder
+	cursorLine := 0
+	line := 0
+	entryIdx := 0
+
+	for i, sec := range m.sections {
+		if i > 0 {
+			b.WriteString("\n")
+			line++
+		}
+
+		collapsed := m.collapsed.isCollapsed(sec.mode)
+		headerSelected := entryIdx == m.cursor
+		if headerSelected {
+			cursorLine = line
+		}
+		b.WriteString(sectionHeaderLine(sec.mode, len(sec.rows), collapsed, headerSelected))
+		line++
+		entryIdx++
+
+		if collapsed {
+			continue
+		}
+
+		for _, row := range sec.rows {
+			b.WriteString("\n")
+			line++
+
+			selected := entryIdx == m.cursor
+			if selected {
+				cursorLine = line
+			}
+			b.WriteString(m.renderRow(row, selected))
+			entryIdx++
+		}
+	}
+
+	return b.String(), cursorLine
+}
+
+// ensureCursorVisible scrolls the viewport just enough to bring line
+// into view.
+func (m *model) ensureCursorVisible(line int) {
+	if line < m.viewport.YOffset {
+		m.viewport.SetYOffset(line)
+	} else if line >= m.viewport.YOffset+m.viewport.Height {
+		m.viewport.SetYOffset(line - m.viewport.Height + 1)
+	}
+}
+
+// refreshViewport re-renders the grouped content from the current
+// sections/collapsed/cursor state and keeps the cursor's row on screen.
+func (m *model) refreshViewport() {
+	content, cursorLine := m.renderContent()
+	m.viewport.SetContent(content)
+	m.ensureCursorVisible(cursorLine)
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -80,6 +571,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 
+	case clearStatusMsg:
+		if msg.id == m.statusMsgID {
+			m.statusMsg = ""
+		}
+		return m, nil
+
+	case nvimSendResultMsg:
+		if msg.err != nil {
+			return m, m.setStatus("Error sending to Neovim: " + msg.err.Error())
+		}
+		return m, m.setStatus("Sent to Neovim")
+
 	case tea.KeyMsg:
 
 		// Always allow ctrl+c
@@ -87,6 +590,96 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		}
 
+		// 💾 SAVE PROMPT (asking where to write when configPath is unknown)
+		if m.savePrompt {
+			switch msg.String() {
+
+			case "enter":
+				path := strings.TrimSpace(m.saveInput.Value())
+				if path == "" {
+					return m, nil
+				}
+
+				if err := saveConfig(m.cfg, path); err != nil {
+					m.setStatusMsg("Error saving: " + err.Error())
+				} else {
+					m.configPath = path
+					m.setStatusMsg("Saved to " + path)
+				}
+
+				m.savePrompt = false
+				m.saveInput.Blur()
+				return m, nil
+
+			case "esc":
+				m.savePrompt = false
+				m.saveInput.Blur()
+				return m, nil
+			}
+
+			m.saveInput, cmd = m.saveInput.Update(msg)
+			return m, cmd
+		}
+
+		// ❓ DELETE CONFIRMATION
+		if m.confirmDelete {
+			switch msg.String() {
+
+			case "y":
+				if idx, ok := m.currentRowIndex(); ok {
+					m.cfg = applyDelete(m.cfg, idx)
+				}
+				m.confirmDelete = false
+				m.rebuildRows()
+				return m, nil
+
+			case "n", "esc":
+				m.confirmDelete = false
+				return m, nil
+			}
+			return m, nil
+		}
+
+		// ✏️ EDIT MODE (add or edit a row)
+		if m.editing {
+			switch msg.String() {
+
+			case "esc":
+				m.editing = false
+				return m, nil
+
+			case "tab":
+				m.editFields[m.editFocus].Blur()
+				m.editFocus = (m.editFocus + 1) % len(m.editFields)
+				m.editFields[m.editFocus].Focus()
+				return m, nil
+
+			case "shift+tab":
+				m.editFields[m.editFocus].Blur()
+				m.editFocus = (m.editFocus - 1 + len(m.editFields)) % len(m.editFields)
+				m.editFields[m.editFocus].Focus()
+				return m, nil
+
+			case "enter":
+				isNewRow := m.editIndex < 0
+				m.cfg = applyEdit(m.cfg, m.editIndex, m.commitEdit())
+				m.editing = false
+
+				// A brand-new row has no Source, so it would otherwise
+				// vanish under an active source filter with no feedback
+				// that the add even happened.
+				if isNewRow && m.sourceFilter != "" {
+					m.sourceFilter = ""
+				}
+
+				m.rebuildRows()
+				return m, nil
+			}
+
+			m.editFields[m.editFocus], cmd = m.editFields[m.editFocus].Update(msg)
+			return m, cmd
+		}
+
 		// 🔎 SEARCH MODE
 		if m.searchMode {
 
@@ -112,6 +705,42 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 
+		// 📁 PENDING 'z' FOLD COMMAND (zc/zo/za/zM/zR, mirroring Vim folds)
+		if m.pendingFold {
+			m.pendingFold = false
+
+			switch msg.String() {
+
+			case "c":
+				if mode, ok := m.currentMode(); ok {
+					m.collapsed.close(mode)
+					m.filterRows(m.search.Value())
+				}
+
+			case "o":
+				if mode, ok := m.currentMode(); ok {
+					m.collapsed.open(mode)
+					m.filterRows(m.search.Value())
+				}
+
+			case "a":
+				if mode, ok := m.currentMode(); ok {
+					m.collapsed.toggle(mode)
+					m.filterRows(m.search.Value())
+				}
+
+			case "M":
+				m.collapsed.closeAll(m.modeOrder)
+				m.filterRows(m.search.Value())
+
+			case "R":
+				m.collapsed.openAll()
+				m.filterRows(m.search.Value())
+			}
+
+			return m, nil
+		}
+
 		// 🧭 NORMAL MODE KEYS
 		switch msg.String() {
 
@@ -121,29 +750,169 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.search.SetValue("")
 			return m, nil
 
+		case "f":
+			m.fuzzy = !m.fuzzy
+			m.filterRows(m.search.Value())
+			return m, nil
+
+		case "s":
+			m.cycleSourceFilter()
+			m.filterRows(m.search.Value())
+			return m, nil
+
+		case "a":
+			m.startAdd()
+			return m, nil
+
+		case "e":
+			m.startEdit()
+			return m, nil
+
+		case "d":
+			if _, ok := m.currentRowIndex(); ok {
+				m.confirmDelete = true
+			}
+			return m, nil
+
+		case "w":
+			m.startSave()
+			return m, nil
+
+		case "y":
+			idx, ok := m.currentRowIndex()
+			if !ok {
+				return m, nil
+			}
+
+			row := m.cfg.Rows[idx]
+			if err := copyKeybind(row); err != nil {
+				return m, m.setStatus("Error copying: " + err.Error())
+			}
+			return m, m.setStatus("Copied " + row.Keybind)
+
+		case "Y":
+			idx, ok := m.currentRowIndex()
+			if !ok {
+				return m, nil
+			}
+
+			row := m.cfg.Rows[idx]
+			if err := copyFormattedLine(row); err != nil {
+				return m, m.setStatus("Error copying: " + err.Error())
+			}
+			return m, m.setStatus("Copied " + formattedCopyLine(row))
+
+		case "x":
+			idx, ok := m.currentRowIndex()
+			if !ok {
+				return m, nil
+			}
+
+			if m.nvimSocket == "" {
+				return m, m.setStatus("No Neovim socket configured (--nvim-socket or $NVIM)")
+			}
+
+			row := m.cfg.Rows[idx]
+			return m, sendToNvimCmd(m.nvimSocket, keySequence(row.Mode, row.Keybind))
+
+		case "z":
+			m.pendingFold = true
+			return m, nil
+
+		case "j", "down":
+			m.moveCursor(1)
+			return m, nil
+
+		case "k", "up":
+			m.moveCursor(-1)
+			return m, nil
+
 		case "q":
 			return m, tea.Quit
 		}
 	}
 
-	// Let table handle j/k navigation
-	m.table, cmd = m.table.Update(msg)
-	return m, cmd
+	return m, nil
+}
+
+// editView renders the add/edit form: one line per field, with the
+// focused field shown by textinput's own cursor.
+func (m model) editView() string {
+	labels := []string{"Mode", "Keybind", "Action"}
+
+	title := "Add keybinding"
+	if m.editIndex >= 0 {
+		title = "Edit keybinding"
+	}
+
+	var b strings.Builder
+	b.WriteString(searchStyle.Render(title) + "\n\n")
+	for i, label := range labels {
+		fmt.Fprintf(&b, "%-8s %s\n", label+":", m.editFields[i].View())
+	}
+	b.WriteString("\n" + helpStyle.Render("tab/shift+tab next/prev field | enter save | esc cancel"))
+
+	return b.String()
+}
+
+// viewportWithScrollbar renders the viewport with a small scrollbar rail
+// along its right edge, built from viewport.ScrollPercent().
+func (m model) viewportWithScrollbar() string {
+	rail := renderScrollbar(m.viewport.Height, m.viewport.ScrollPercent())
+
+	lines := strings.Split(m.viewport.View(), "\n")
+	for i := range lines {
+		if i < len(rail) {
+			lines[i] += " " + rail[i]
+		}
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 func (m model) View() string {
-	tableView := baseStyle.Render(m.table.View())
+	if m.editing {
+		return m.editView()
+	}
+
+	if m.savePrompt {
+		return searchStyle.Render("Save to: ") + m.saveInput.View() +
+			"\n\n" + helpStyle.Render("enter to save | esc to cancel")
+	}
+
+	tableView := baseStyle.Render(m.viewportWithScrollbar())
 
 	// Search visualiser
+	modeLabel := ""
+	if m.fuzzy {
+		modeLabel = " [fuzzy]"
+	}
+
+	sourceLabel := ""
+	if m.showSource {
+		current := m.sourceFilter
+		if current == "" {
+			current = "all"
+		}
+		sourceLabel = " [source: " + current + "]"
+	}
+
 	var searchLine string
 	if m.searchMode {
-		searchLine = searchStyle.Render("Search: ") + m.search.View()
-	} else if m.search.Value() != "" {
-		searchLine = searchStyle.Render("Filtered by: ") + m.search.Value()
+		searchLine = searchStyle.Render("Search: ") + m.search.View() + modeLabel + sourceLabel
+	} else if m.search.Value() != "" || sourceLabel != "" {
+		searchLine = searchStyle.Render("Filtered by: ") + m.search.Value() + modeLabel + sourceLabel
+	}
+
+	if m.confirmDelete {
+		searchLine = searchStyle.Render("Delete selected row? (y/n)")
 	}
 
 	// Help footer (always shown)
-	helpLine := helpStyle.Render("Press '/' to search | j/k to move | q to quit")
+	helpLine := helpStyle.Render("Press '/' to search | f fuzzy | s cycle source | a add | e edit | d delete | w write | y/Y copy | x send to nvim | zc/zo/za fold | zM/zR fold all | j/k to move | q to quit")
+	if m.statusMsg != "" {
+		helpLine += "\n" + helpStyle.Render(m.statusMsg)
+	}
 
 	// Compose view
 	if searchLine != "" {
@@ -229,20 +998,139 @@ func defaultConfig() appConfig {
 	}
 }
 
-func resolveConfigPath(args []string, envValue string) (string, error) {
-	flags := flag.NewFlagSet("nvim-simple-keybind-helper", flag.ContinueOnError)
+// stringSliceFlag accumulates every occurrence of a repeatable flag, in
+// the order they were given, so --config can be passed more than once.
+type stringSliceFlag []string
+
+func (s *stringSliceFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSliceFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// newFlagSet declares every flag the CLI understands. Individual resolve*
+// functions each parse the same definitions so that passing flags they
+// don't care about together doesn't trip "flag provided but not defined".
+func newFlagSet() (flags *flag.FlagSet, configPaths *stringSliceFlag, fuzzy *bool, importPath *string, nvimSocket *string) {
+	flags = flag.NewFlagSet("nvim-simple-keybind-helper", flag.ContinueOnError)
 	flags.SetOutput(io.Discard)
 
-	configPathFlag := flags.String("config", "", "path to JSON config file")
+	configPaths = &stringSliceFlag{}
+	flags.Var(configPaths, "config", "path to a JSON config file (repeatable to layer configs)")
+	fuzzy = flags.Bool("fuzzy", false, "start in fuzzy-match mode")
+	importPath = flags.String("import", "", "path to a Neovim config directory to read keymaps from live")
+	nvimSocket = flags.String("nvim-socket", "", "path to a running Neovim instance's msgpack-RPC socket, for 'x' (defaults to $NVIM)")
+
+	return flags, configPaths, fuzzy, importPath, nvimSocket
+}
+
+// resolveConfigPaths returns the config files to load, in layering order
+// (earlier entries load first; later entries' rows win on conflict). The
+// --config flag may be repeated; if it's absent entirely, NVIM_HELPER_CONFIG
+// is split on ':' instead.
+func resolveConfigPaths(args []string, envValue string) ([]string, error) {
+	flags, configPathsFlag, _, _, _ := newFlagSet()
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if len(*configPathsFlag) > 0 {
+		return []string(*configPathsFlag), nil
+	}
+
+	return splitEnvConfigPaths(envValue), nil
+}
+
+func splitEnvConfigPaths(envValue string) []string {
+	var paths []string
+	for _, part := range strings.Split(envValue, ":") {
+		if path := strings.TrimSpace(part); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+func resolveFuzzyMode(args []string) (bool, error) {
+	flags, _, fuzzyFlag, _, _ := newFlagSet()
+	if err := flags.Parse(args); err != nil {
+		return false, err
+	}
+
+	return *fuzzyFlag, nil
+}
+
+func resolveImportPath(args []string) (string, error) {
+	flags, _, _, importPathFlag, _ := newFlagSet()
 	if err := flags.Parse(args); err != nil {
 		return "", err
 	}
 
-	if path := strings.TrimSpace(*configPathFlag); path != "" {
-		return path, nil
+	return strings.TrimSpace(*importPathFlag), nil
+}
+
+// resolveNvimSocketFlag parses --nvim-socket and falls back to $NVIM when
+// it's not given, for the 'x' send-to-Neovim action.
+func resolveNvimSocketFlag(args []string) (string, error) {
+	flags, _, _, _, nvimSocketFlag := newFlagSet()
+	if err := flags.Parse(args); err != nil {
+		return "", err
 	}
 
-	return strings.TrimSpace(envValue), nil
+	return resolveNvimSocket(strings.TrimSpace(*nvimSocketFlag)), nil
+}
+
+// keymapsToRowConfigs converts parsed Neovim keymaps into the rowConfig
+// shape used throughout the rest of the app.
+func keymapsToRowConfigs(keymaps []nvimparse.Keymap) []rowConfig {
+	rows := make([]rowConfig, 0, len(keymaps))
+
+	for _, k := range keymaps {
+		rows = append(rows, rowConfig{Mode: k.Mode, Keybind: k.Keybind, Action: k.Action})
+	}
+
+	return rows
+}
+
+// runImportCommand implements the `import` subcommand: parse a Neovim
+// config directory into an appConfig and write it out as JSON, either to
+// a file (-o) or stdout.
+func runImportCommand(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	outPath := flags.String("o", "", "write the generated config to this file instead of stdout")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("usage: nvim-simple-keybind-helper import <nvim-config-dir> [-o out.json]")
+	}
+
+	keymaps, err := nvimparse.ParseDir(flags.Arg(0))
+	if err != nil {
+		return fmt.Errorf("parsing neovim config: %w", err)
+	}
+
+	cfg := defaultConfig()
+	cfg.Rows = keymapsToRowConfigs(keymaps)
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling config: %w", err)
+	}
+	data = append(data, '\n')
+
+	if *outPath == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	return os.WriteFile(*outPath, data, 0o644)
 }
 
 func loadConfig(path string) (appConfig, error) {
@@ -273,6 +1161,117 @@ func loadConfig(path string) (appConfig, error) {
 	return cfg, nil
 }
 
+// sourceNameFromPath derives a Source column value from a config path:
+// its filename without extension, e.g. "telescope.json" -> "telescope".
+func sourceNameFromPath(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// loadMergedConfig loads and layers one or more config files: later
+// files append rows, a row sharing a (Mode, Keybind) with an earlier one
+// overrides it in place, and a row with Remove set deletes the inherited
+// row instead of replacing it. Columns, Height and HideSourceColumn come
+// from the last file that set them (falling back to defaults).
+func loadMergedConfig(paths []string) (appConfig, error) {
+	defaultCfg := defaultConfig()
+
+	cfg := appConfig{}
+	index := map[string]int{}
+
+	for _, path := range paths {
+		loaded, err := loadConfig(path)
+		if err != nil {
+			return appConfig{}, fmt.Errorf("loading %s: %w", path, err)
+		}
+
+		cfg.Columns = loaded.Columns
+		cfg.Height = loaded.Height
+		cfg.HideSourceColumn = loaded.HideSourceColumn
+
+		source := sourceNameFromPath(path)
+
+		for _, row := range loaded.Rows {
+			key := row.Mode + "\x00" + row.Keybind
+
+			if row.Remove {
+				if i, ok := index[key]; ok {
+					cfg.Rows = append(cfg.Rows[:i], cfg.Rows[i+1:]...)
+					delete(index, key)
+					for k, v := range index {
+						if v > i {
+							index[k] = v - 1
+						}
+					}
+				}
+				continue
+			}
+
+			row.Source = source
+
+			if i, ok := index[key]; ok {
+				cfg.Rows[i] = row
+				continue
+			}
+
+			index[key] = len(cfg.Rows)
+			cfg.Rows = append(cfg.Rows, row)
+		}
+	}
+
+	if len(cfg.Columns) == 0 {
+		cfg.Columns = defaultCfg.Columns
+	}
+	if cfg.Height <= 0 {
+		cfg.Height = defaultCfg.Height
+	}
+
+	return cfg, nil
+}
+
+// applyEdit returns a copy of cfg with row written at index, or appended
+// if index is out of range (used for the 'a' append command, which
+// passes -1). It's a pure function so the edit-mode 'enter' commit and
+// its tests don't need a live model.
+func applyEdit(cfg appConfig, index int, row rowConfig) appConfig {
+	rows := append([]rowConfig(nil), cfg.Rows...)
+
+	if index < 0 || index >= len(rows) {
+		rows = append(rows, row)
+	} else {
+		rows[index] = row
+	}
+
+	cfg.Rows = rows
+	return cfg
+}
+
+// applyDelete returns a copy of cfg with the row at index removed. An
+// out-of-range index is a no-op.
+func applyDelete(cfg appConfig, index int) appConfig {
+	if index < 0 || index >= len(cfg.Rows) {
+		return cfg
+	}
+
+	rows := append([]rowConfig(nil), cfg.Rows[:index]...)
+	rows = append(rows, cfg.Rows[index+1:]...)
+	cfg.Rows = rows
+	return cfg
+}
+
+// saveConfig marshals cfg back to path in the same format the `import`
+// subcommand writes, preserving Columns, Height and any other fields
+// untouched by editing.
+func saveConfig(cfg appConfig, path string) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling config: %w", err)
+	}
+	data = append(data, '\n')
+
+	return os.WriteFile(path, data, 0o644)
+}
+
 func configColumnsToTableColumns(columns []columnConfig) []table.Column {
 	tableColumns := make([]table.Column, 0, len(columns))
 
@@ -293,56 +1292,128 @@ func configRowsToTableRows(rows []rowConfig) []table.Row {
 	return tableRows
 }
 
+// withSourceColumn appends each row's Source as a trailing column,
+// turning table.Row{mode, keybind, action} into
+// table.Row{mode, keybind, action, source}.
+func withSourceColumn(tableRows []table.Row, rows []rowConfig) []table.Row {
+	out := make([]table.Row, len(tableRows))
+	for i, row := range tableRows {
+		out[i] = append(append(table.Row{}, row...), rows[i].Source)
+	}
+	return out
+}
+
+// distinctSources returns the Source values present in rows, in the
+// order each is first seen.
+func distinctSources(rows []rowConfig) []string {
+	seen := map[string]bool{}
+	var sources []string
+	for _, row := range rows {
+		if row.Source == "" || seen[row.Source] {
+			continue
+		}
+		seen[row.Source] = true
+		sources = append(sources, row.Source)
+	}
+	return sources
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := runImportCommand(os.Args[2:]); err != nil {
+			fmt.Printf("Error importing Neovim config: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	cfg := defaultConfig()
 
-	configPath, err := resolveConfigPath(os.Args[1:], os.Getenv("NVIM_HELPER_CONFIG"))
+	configPaths, err := resolveConfigPaths(os.Args[1:], os.Getenv("NVIM_HELPER_CONFIG"))
 	if err != nil {
 		fmt.Printf("Error parsing flags: %v\n", err)
 		os.Exit(1)
 	}
 
-	if configPath != "" {
-		loadedCfg, err := loadConfig(configPath)
+	fuzzy, err := resolveFuzzyMode(os.Args[1:])
+	if err != nil {
+		fmt.Printf("Error parsing flags: %v\n", err)
+		os.Exit(1)
+	}
+
+	importPath, err := resolveImportPath(os.Args[1:])
+	if err != nil {
+		fmt.Printf("Error parsing flags: %v\n", err)
+		os.Exit(1)
+	}
+
+	nvimSocket, err := resolveNvimSocketFlag(os.Args[1:])
+	if err != nil {
+		fmt.Printf("Error parsing flags: %v\n", err)
+		os.Exit(1)
+	}
+
+	switch {
+	case importPath != "":
+		keymaps, err := nvimparse.ParseDir(importPath)
 		if err != nil {
-			fmt.Printf("Error loading config from %s: %v\n", configPath, err)
+			fmt.Printf("Error importing Neovim config from %s: %v\n", importPath, err)
+			os.Exit(1)
+		}
+		cfg.Rows = keymapsToRowConfigs(keymaps)
+
+	case len(configPaths) > 0:
+		loadedCfg, err := loadMergedConfig(configPaths)
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
 			os.Exit(1)
 		}
 
 		cfg = loadedCfg
 	}
 
+	sources := distinctSources(cfg.Rows)
+	showSource := len(sources) > 0 && !cfg.HideSourceColumn
+
 	columns := configColumnsToTableColumns(cfg.Columns)
 	rows := configRowsToTableRows(cfg.Rows)
+	if showSource {
+		columns = append(columns, table.Column{Title: "Source", Width: 12})
+		rows = withSourceColumn(rows, cfg.Rows)
+	}
 
-	t := table.New(
-		table.WithColumns(columns),
-		table.WithRows(rows),
-		table.WithFocused(true),
-		table.WithHeight(cfg.Height),
-	)
-
-	s := table.DefaultStyles()
-	s.Header = s.Header.
-		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		BorderBottom(true)
-
-	s.Selected = s.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57"))
-
-	t.SetStyles(s)
+	// +2 for the single-space gutter and scrollbar rune to the right of
+	// the widest row.
+	vp := viewport.New(totalColumnsWidth(columns)+2, cfg.Height)
 
 	search := textinput.New()
 	search.Placeholder = "Search actions..."
 	search.Width = 30
 
+	// configPath is only known when exactly one --config file was given;
+	// --import and layered --config files have no single file to write
+	// 'w' back to, so the model falls back to prompting for one.
+	configPath := ""
+	if len(configPaths) == 1 {
+		configPath = configPaths[0]
+	}
+
 	m := model{
-		table:   t,
-		allRows: rows,
-		search:  search,
+		viewport:   vp,
+		columns:    columns,
+		allRows:    rows,
+		modeOrder:  distinctModes(cfg.Rows),
+		collapsed:  foldState{},
+		search:     search,
+		fuzzy:      fuzzy,
+		showSource: showSource,
+		sources:    sources,
+		cfg:        cfg,
+		configPath: configPath,
+		editIndex:  -1,
+		nvimSocket: nvimSocket,
 	}
+	m.filterRows("")
 
 	if _, err := tea.NewProgram(m).Run(); err != nil {
 		fmt.Println("Error running program:", err)
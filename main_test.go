@@ -9,26 +9,41 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestResolveConfigPath_FlagOverridesEnv(t *testing.T) {
-	path, err := resolveConfigPath([]string{"--config", "./custom.json"}, "./from-env.json")
+func TestResolveConfigPaths_FlagOverridesEnv(t *testing.T) {
+	paths, err := resolveConfigPaths([]string{"--config", "./custom.json"}, "./from-env.json")
 	require.NoError(t, err)
-	require.Equal(t, "./custom.json", path)
+	require.Equal(t, []string{"./custom.json"}, paths)
 }
 
-func TestResolveConfigPath_UsesEnvWhenFlagMissing(t *testing.T) {
-	path, err := resolveConfigPath(nil, " ./from-env.json ")
+func TestResolveConfigPaths_FlagIsRepeatable(t *testing.T) {
+	paths, err := resolveConfigPaths(
+		[]string{"--config", "base.json", "--config", "telescope.json", "--config", "overrides.json"},
+		"",
+	)
 	require.NoError(t, err)
-	require.Equal(t, "./from-env.json", path)
+	require.Equal(t, []string{"base.json", "telescope.json", "overrides.json"}, paths)
 }
 
-func TestResolveConfigPath_EmptyWhenUnset(t *testing.T) {
-	path, err := resolveConfigPath(nil, "")
+func TestResolveConfigPaths_UsesEnvWhenFlagMissing(t *testing.T) {
+	paths, err := resolveConfigPaths(nil, " ./from-env.json ")
 	require.NoError(t, err)
-	require.Equal(t, "", path)
+	require.Equal(t, []string{"./from-env.json"}, paths)
 }
 
-func TestResolveConfigPath_ReturnsErrorForUnknownFlag(t *testing.T) {
-	_, err := resolveConfigPath([]string{"--unknown"}, "")
+func TestResolveConfigPaths_SplitsEnvOnColon(t *testing.T) {
+	paths, err := resolveConfigPaths(nil, "base.json: telescope.json :overrides.json")
+	require.NoError(t, err)
+	require.Equal(t, []string{"base.json", "telescope.json", "overrides.json"}, paths)
+}
+
+func TestResolveConfigPaths_EmptyWhenUnset(t *testing.T) {
+	paths, err := resolveConfigPaths(nil, "")
+	require.NoError(t, err)
+	require.Nil(t, paths)
+}
+
+func TestResolveConfigPaths_ReturnsErrorForUnknownFlag(t *testing.T) {
+	_, err := resolveConfigPaths([]string{"--unknown"}, "")
 	require.Error(t, err)
 }
 
@@ -83,3 +98,202 @@ func TestConfigRowsToTableRows(t *testing.T) {
 
 	require.Equal(t, []table.Row{{"normal", "gd", "go to definition"}}, converted)
 }
+
+func writeTestConfig(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoadMergedConfig_LaterFileOverridesSameModeAndKeybind(t *testing.T) {
+	dir := t.TempDir()
+
+	base := writeTestConfig(t, dir, "base.json", `{
+  "rows": [{"mode": "normal", "keybind": "gd", "action": "go to definition"}]
+}`)
+	overrides := writeTestConfig(t, dir, "overrides.json", `{
+  "rows": [{"mode": "normal", "keybind": "gd", "action": "go to definition (custom)"}]
+}`)
+
+	cfg, err := loadMergedConfig([]string{base, overrides})
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Rows, 1)
+	require.Equal(t, "go to definition (custom)", cfg.Rows[0].Action)
+	require.Equal(t, "overrides", cfg.Rows[0].Source)
+}
+
+func TestLoadMergedConfig_AppendsNonConflictingRows(t *testing.T) {
+	dir := t.TempDir()
+
+	base := writeTestConfig(t, dir, "base.json", `{
+  "rows": [{"mode": "normal", "keybind": "gd", "action": "go to definition"}]
+}`)
+	telescope := writeTestConfig(t, dir, "telescope.json", `{
+  "rows": [{"mode": "normal", "keybind": "<leader>ff", "action": "find file"}]
+}`)
+
+	cfg, err := loadMergedConfig([]string{base, telescope})
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Rows, 2)
+	require.Equal(t, "base", cfg.Rows[0].Source)
+	require.Equal(t, "telescope", cfg.Rows[1].Source)
+}
+
+func TestLoadMergedConfig_RemoveDeletesInheritedRow(t *testing.T) {
+	dir := t.TempDir()
+
+	base := writeTestConfig(t, dir, "base.json", `{
+  "rows": [
+    {"mode": "normal", "keybind": "gd", "action": "go to definition"},
+    {"mode": "normal", "keybind": "K", "action": "hover documentation"}
+  ]
+}`)
+	personal := writeTestConfig(t, dir, "personal.json", `{
+  "rows": [{"mode": "normal", "keybind": "gd", "remove": true}]
+}`)
+
+	cfg, err := loadMergedConfig([]string{base, personal})
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Rows, 1)
+	require.Equal(t, "K", cfg.Rows[0].Keybind)
+}
+
+func TestDistinctSources_PreservesFirstSeenOrder(t *testing.T) {
+	rows := []rowConfig{
+		{Source: "base"},
+		{Source: "telescope"},
+		{Source: "base"},
+		{Source: "personal"},
+	}
+
+	require.Equal(t, []string{"base", "telescope", "personal"}, distinctSources(rows))
+}
+
+func TestModel_CycleSourceFilterWrapsThroughAllSources(t *testing.T) {
+	m := model{showSource: true, sources: []string{"base", "telescope"}}
+
+	m.cycleSourceFilter()
+	require.Equal(t, "base", m.sourceFilter)
+
+	m.cycleSourceFilter()
+	require.Equal(t, "telescope", m.sourceFilter)
+
+	m.cycleSourceFilter()
+	require.Equal(t, "", m.sourceFilter)
+}
+
+func TestModel_SourceFilteredIndicesKeepsOnlySelectedSource(t *testing.T) {
+	m := model{
+		showSource: true,
+		allRows: []table.Row{
+			{"normal", "gd", "go to definition", "base"},
+			{"normal", "<leader>ff", "find file", "telescope"},
+		},
+		sourceFilter: "telescope",
+	}
+
+	indices := m.sourceFilteredIndices()
+
+	require.Equal(t, []int{1}, indices)
+}
+
+func TestApplyEdit_ReplacesRowAtIndex(t *testing.T) {
+	cfg := appConfig{Rows: []rowConfig{
+		{Mode: "normal", Keybind: "gd", Action: "go to definition"},
+		{Mode: "normal", Keybind: "K", Action: "hover documentation"},
+	}}
+
+	updated := applyEdit(cfg, 0, rowConfig{Mode: "normal", Keybind: "gD", Action: "go to declaration"})
+
+	require.Len(t, updated.Rows, 2)
+	require.Equal(t, "gD", updated.Rows[0].Keybind)
+	require.Equal(t, "K", updated.Rows[1].Keybind)
+}
+
+func TestApplyEdit_AppendsRowForOutOfRangeIndex(t *testing.T) {
+	cfg := appConfig{Rows: []rowConfig{{Mode: "normal", Keybind: "gd", Action: "go to definition"}}}
+
+	updated := applyEdit(cfg, -1, rowConfig{Mode: "normal", Keybind: "grr", Action: "show references"})
+
+	require.Len(t, updated.Rows, 2)
+	require.Equal(t, "grr", updated.Rows[1].Keybind)
+}
+
+func TestApplyEdit_DoesNotMutateOriginalRows(t *testing.T) {
+	cfg := appConfig{Rows: []rowConfig{{Mode: "normal", Keybind: "gd", Action: "go to definition"}}}
+
+	applyEdit(cfg, 0, rowConfig{Mode: "normal", Keybind: "gD", Action: "go to declaration"})
+
+	require.Equal(t, "gd", cfg.Rows[0].Keybind)
+}
+
+func TestApplyDelete_RemovesRowAtIndex(t *testing.T) {
+	cfg := appConfig{Rows: []rowConfig{
+		{Mode: "normal", Keybind: "gd", Action: "go to definition"},
+		{Mode: "normal", Keybind: "K", Action: "hover documentation"},
+	}}
+
+	updated := applyDelete(cfg, 0)
+
+	require.Len(t, updated.Rows, 1)
+	require.Equal(t, "K", updated.Rows[0].Keybind)
+}
+
+func TestApplyDelete_OutOfRangeIndexIsNoOp(t *testing.T) {
+	cfg := appConfig{Rows: []rowConfig{{Mode: "normal", Keybind: "gd", Action: "go to definition"}}}
+
+	updated := applyDelete(cfg, 5)
+
+	require.Len(t, updated.Rows, 1)
+}
+
+func TestEditSaveRoundTrip_LoadEditSaveReloadMatches(t *testing.T) {
+	dir := t.TempDir()
+	configPath := writeTestConfig(t, dir, "config.json", `{
+  "columns": [{"title": "Mode", "width": 8}, {"title": "Keybind", "width": 16}, {"title": "Action", "width": 40}],
+  "height": 10,
+  "rows": [
+    {"mode": "normal", "keybind": "gd", "action": "go to definition"},
+    {"mode": "normal", "keybind": "K", "action": "hover documentation"}
+  ]
+}`)
+
+	cfg, err := loadConfig(configPath)
+	require.NoError(t, err)
+
+	edited := applyEdit(cfg, 0, rowConfig{Mode: "normal", Keybind: "gD", Action: "go to declaration"})
+	edited = applyEdit(edited, -1, rowConfig{Mode: "normal", Keybind: "grr", Action: "show references"})
+
+	require.NoError(t, saveConfig(edited, configPath))
+
+	reloaded, err := loadConfig(configPath)
+	require.NoError(t, err)
+
+	require.Equal(t, edited.Columns, reloaded.Columns)
+	require.Equal(t, edited.Height, reloaded.Height)
+	require.Equal(t, edited.Rows, reloaded.Rows)
+}
+
+func TestSetStatusMsg_BumpsIDSoStalePendingTickCantClearIt(t *testing.T) {
+	m := &model{}
+
+	cmd := m.setStatus("Copied gd")
+	require.Equal(t, "Copied gd", m.statusMsg)
+	pendingClear := cmd().(clearStatusMsg)
+
+	m.setStatusMsg("Saved to config.json")
+	require.Equal(t, "Saved to config.json", m.statusMsg)
+
+	var handled bool
+	if pendingClear.id == m.statusMsgID {
+		handled = true
+		m.statusMsg = ""
+	}
+
+	require.False(t, handled, "a tick scheduled for the earlier status shouldn't match after a newer one is set")
+	require.Equal(t, "Saved to config.json", m.statusMsg)
+}
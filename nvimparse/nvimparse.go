@@ -0,0 +1,390 @@
+// Package nvimparse extracts keymap definitions from a Neovim Lua
+// configuration so they can be turned into rows for the keybind helper
+// without the user hand-writing a JSON cheatsheet.
+//
+// It is a pragmatic line/paren scanner rather than a full Lua parser: it
+// understands the handful of call shapes Neovim configs actually use
+// (vim.keymap.set, vim.api.nvim_set_keymap, vim.api.nvim_buf_set_keymap)
+// and ignores everything else.
+package nvimparse
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Keymap is a single extracted keybinding, mirroring the shape the helper
+// renders in its table.
+type Keymap struct {
+	Mode    string
+	Keybind string
+	Action  string
+}
+
+var modeNames = map[string]string{
+	"n": "normal",
+	"i": "insert",
+	"v": "visual",
+	"x": "visual",
+	"s": "select",
+	"o": "operator",
+	"c": "command",
+	"t": "terminal",
+}
+
+func modeName(raw string) string {
+	if name, ok := modeNames[strings.ToLower(raw)]; ok {
+		return name
+	}
+	return raw
+}
+
+var mapleaderRe = regexp.MustCompile(`vim\.g\.mapleader\s*=\s*(['"])((?:\\.|[^\\])*?)['"]`)
+
+// findMapleader scans source for a `vim.g.mapleader = "..."` assignment
+// and returns its value, or the Neovim default ("\\") if none is set.
+func findMapleader(sources []string) string {
+	for _, src := range sources {
+		if m := mapleaderRe.FindStringSubmatch(stripComments(src)); m != nil {
+			return unescapeLuaString(m[2])
+		}
+	}
+	return `\`
+}
+
+// ParseDir walks dir for *.lua files and extracts every keymap it
+// recognises, resolving <leader> against a mapleader assignment found
+// anywhere in the tree. Files are visited in sorted path order so output
+// is deterministic.
+func ParseDir(dir string) ([]Keymap, error) {
+	var paths []string
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if strings.EqualFold(filepath.Ext(path), ".lua") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking %s: %w", dir, err)
+	}
+	sort.Strings(paths)
+
+	sources := make([]string, 0, len(paths))
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		sources = append(sources, string(content))
+	}
+
+	leader := findMapleader(sources)
+
+	var keymaps []Keymap
+	for _, src := range sources {
+		keymaps = append(keymaps, Parse(src, leader)...)
+	}
+
+	return keymaps, nil
+}
+
+// Parse extracts keymaps from a single Lua source string, resolving
+// <leader> to the given value.
+func Parse(source, leader string) []Keymap {
+	source = stripComments(source)
+
+	var keymaps []Keymap
+	for _, call := range findCalls(source) {
+		keymaps = append(keymaps, callToKeymaps(call, leader)...)
+	}
+	return keymaps
+}
+
+type call struct {
+	fn   string
+	args []string
+}
+
+var callHeadRe = regexp.MustCompile(`(vim\.keymap\.set|vim\.api\.nvim_set_keymap|vim\.api\.nvim_buf_set_keymap)\s*\(`)
+
+// findCalls locates every recognised call in source and splits its
+// argument list on top-level commas (i.e. not inside nested
+// parens/braces/brackets/strings).
+func findCalls(source string) []call {
+	var calls []call
+
+	for _, loc := range callHeadRe.FindAllStringSubmatchIndex(source, -1) {
+		fn := source[loc[2]:loc[3]]
+		openParen := loc[1] - 1
+
+		argsRaw, ok := extractBalanced(source, openParen)
+		if !ok {
+			continue
+		}
+
+		calls = append(calls, call{fn: fn, args: splitTopLevel(argsRaw)})
+	}
+
+	return calls
+}
+
+// extractBalanced returns the text between the paren at openIdx and its
+// matching close paren, not including either paren.
+func extractBalanced(s string, openIdx int) (string, bool) {
+	depth := 0
+	inString := byte(0)
+
+	for i := openIdx; i < len(s); i++ {
+		c := s[i]
+
+		if inString != 0 {
+			if c == '\\' {
+				i++
+				continue
+			}
+			if c == inString {
+				inString = 0
+			}
+			continue
+		}
+
+		switch c {
+		case '"', '\'':
+			inString = c
+		case '(', '{', '[':
+			depth++
+		case ')', '}', ']':
+			depth--
+			if depth == 0 {
+				return s[openIdx+1 : i], true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// splitTopLevel splits a Lua argument list on commas that are not nested
+// inside parens/braces/brackets/strings.
+func splitTopLevel(s string) []string {
+	var (
+		args     []string
+		depth    int
+		inString byte
+		start    int
+	)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if inString != 0 {
+			if c == '\\' {
+				i++
+				continue
+			}
+			if c == inString {
+				inString = 0
+			}
+			continue
+		}
+
+		switch c {
+		case '"', '\'':
+			inString = c
+		case '(', '{', '[':
+			depth++
+		case ')', '}', ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if start < len(s) {
+		args = append(args, strings.TrimSpace(s[start:]))
+	}
+
+	return args
+}
+
+var quotedRe = regexp.MustCompile(`^(['"])((?:\\.|[^\\])*?)['"]$`)
+
+func asQuotedString(arg string) (string, bool) {
+	m := quotedRe.FindStringSubmatch(arg)
+	if m == nil {
+		return "", false
+	}
+	return unescapeLuaString(m[2]), true
+}
+
+// asModeList parses a mode argument, which is either a quoted string
+// ("n") or a table literal of quoted strings ({"n", "v"}).
+func asModeList(arg string) []string {
+	if mode, ok := asQuotedString(arg); ok {
+		return []string{mode}
+	}
+
+	if strings.HasPrefix(arg, "{") && strings.HasSuffix(arg, "}") {
+		inner := arg[1 : len(arg)-1]
+		var modes []string
+		for _, part := range splitTopLevel(inner) {
+			if mode, ok := asQuotedString(part); ok {
+				modes = append(modes, mode)
+			}
+		}
+		return modes
+	}
+
+	return nil
+}
+
+var descFieldRe = regexp.MustCompile(`desc\s*=\s*(['"])((?:\\.|[^\\])*?)['"]`)
+
+// optsDesc pulls the `desc` field out of an opts table literal, if present.
+func optsDesc(arg string) (string, bool) {
+	m := descFieldRe.FindStringSubmatch(arg)
+	if m == nil {
+		return "", false
+	}
+	return unescapeLuaString(m[2]), true
+}
+
+// callToKeymaps converts one parsed call into zero or more keymaps (one
+// per mode).
+func callToKeymaps(c call, leader string) []Keymap {
+	var modeArg, lhsArg, rhsArg, optsArg string
+
+	switch c.fn {
+	case "vim.keymap.set":
+		if len(c.args) < 3 {
+			return nil
+		}
+		modeArg, lhsArg, rhsArg = c.args[0], c.args[1], c.args[2]
+		if len(c.args) > 3 {
+			optsArg = c.args[3]
+		}
+	case "vim.api.nvim_set_keymap":
+		if len(c.args) < 3 {
+			return nil
+		}
+		modeArg, lhsArg, rhsArg = c.args[0], c.args[1], c.args[2]
+		if len(c.args) > 3 {
+			optsArg = c.args[3]
+		}
+	case "vim.api.nvim_buf_set_keymap":
+		// (bufnr, mode, lhs, rhs, opts)
+		if len(c.args) < 4 {
+			return nil
+		}
+		modeArg, lhsArg, rhsArg = c.args[1], c.args[2], c.args[3]
+		if len(c.args) > 4 {
+			optsArg = c.args[4]
+		}
+	default:
+		return nil
+	}
+
+	lhs, ok := asQuotedString(lhsArg)
+	if !ok {
+		return nil
+	}
+	lhs = resolveLeader(lhs, leader)
+
+	action, ok := optsDesc(optsArg)
+	if !ok {
+		if rhs, isString := asQuotedString(rhsArg); isString {
+			action = rhs
+		} else {
+			action = collapseWhitespace(rhsArg)
+		}
+	}
+
+	modes := asModeList(modeArg)
+	if len(modes) == 0 {
+		return nil
+	}
+
+	keymaps := make([]Keymap, 0, len(modes))
+	for _, mode := range modes {
+		keymaps = append(keymaps, Keymap{
+			Mode:    modeName(mode),
+			Keybind: lhs,
+			Action:  action,
+		})
+	}
+	return keymaps
+}
+
+// leaderRe matches <leader> case-insensitively: Neovim treats <Leader>
+// and <leader> identically, and lhs strings commonly use either spelling.
+var leaderRe = regexp.MustCompile(`(?i)<leader>`)
+
+func resolveLeader(lhs, leader string) string {
+	return leaderRe.ReplaceAllStringFunc(lhs, func(string) string { return leader })
+}
+
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func unescapeLuaString(s string) string {
+	replacer := strings.NewReplacer(`\"`, `"`, `\'`, `'`, `\\`, `\`, `\n`, "\n", `\t`, "\t")
+	return replacer.Replace(s)
+}
+
+var blockCommentRe = regexp.MustCompile(`(?s)--\[\[.*?\]\]`)
+
+// stripComments removes Lua block comments (--[[ ... ]]) and line
+// comments (-- ...), the latter computed line by line so a "--" inside a
+// string literal is never mistaken for a comment.
+func stripComments(source string) string {
+	source = blockCommentRe.ReplaceAllString(source, "")
+
+	lines := strings.Split(source, "\n")
+	for i, line := range lines {
+		lines[i] = stripLineComment(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func stripLineComment(line string) string {
+	var inString byte
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+
+		if inString != 0 {
+			if c == '\\' {
+				i++
+				continue
+			}
+			if c == inString {
+				inString = 0
+			}
+			continue
+		}
+
+		switch {
+		case c == '"' || c == '\'':
+			inString = c
+		case c == '-' && i+1 < len(line) && line[i+1] == '-':
+			return line[:i]
+		}
+	}
+
+	return line
+}
@@ -0,0 +1,119 @@
+package nvimparse
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_KeymapSetWithDesc(t *testing.T) {
+	src := `vim.keymap.set("n", "gd", vim.lsp.buf.definition, { desc = "go to definition" })`
+
+	keymaps := Parse(src, `\`)
+
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: "gd", Action: "go to definition"},
+	}, keymaps)
+}
+
+func TestParse_ModeTableProducesOneRowPerMode(t *testing.T) {
+	src := `vim.keymap.set({"n", "v"}, "<leader>h", "<cmd>HarpoonMenu<CR>", { desc = "open harpoon menu" })`
+
+	keymaps := Parse(src, ",")
+
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: ",h", Action: "open harpoon menu"},
+		{Mode: "visual", Keybind: ",h", Action: "open harpoon menu"},
+	}, keymaps)
+}
+
+func TestParse_ResolvesLeaderCaseInsensitively(t *testing.T) {
+	src := `vim.keymap.set("n", "<Leader>ff", "<cmd>Telescope find_files<CR>", { desc = "find file" })`
+
+	keymaps := Parse(src, " ")
+
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: " ff", Action: "find file"},
+	}, keymaps)
+}
+
+func TestParse_FallsBackToStringifiedRhsWithoutDesc(t *testing.T) {
+	src := `vim.keymap.set("n", "<leader>ff", "<cmd>Telescope find_files<CR>")`
+
+	keymaps := Parse(src, `\`)
+
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: `\ff`, Action: "<cmd>Telescope find_files<CR>"},
+	}, keymaps)
+}
+
+func TestParse_NvimSetKeymap(t *testing.T) {
+	src := `vim.api.nvim_set_keymap('n', 'gr', ':lua vim.lsp.buf.references()<CR>', { desc = "show references" })`
+
+	keymaps := Parse(src, `\`)
+
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: "gr", Action: "show references"},
+	}, keymaps)
+}
+
+func TestParse_NvimBufSetKeymapSkipsBufnr(t *testing.T) {
+	src := `vim.api.nvim_buf_set_keymap(0, 'n', 'K', '<cmd>lua vim.lsp.buf.hover()<CR>', { desc = "hover documentation" })`
+
+	keymaps := Parse(src, `\`)
+
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: "K", Action: "hover documentation"},
+	}, keymaps)
+}
+
+func TestParse_SkipsLinesInsideComments(t *testing.T) {
+	src := `
+-- vim.keymap.set("n", "gd", "x", { desc = "should be ignored" })
+--[[
+vim.keymap.set("n", "gx", "y", { desc = "also ignored" })
+]]
+vim.keymap.set("n", "K", "z", { desc = "hover" })
+`
+
+	keymaps := Parse(src, `\`)
+
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: "K", Action: "hover"},
+	}, keymaps)
+}
+
+func TestParseDir_ResolvesMapleaderAcrossFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "options.lua"), []byte(`vim.g.mapleader = " "`), 0o644)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "keymaps.lua"), []byte(
+		`vim.keymap.set("n", "<leader>ff", "<cmd>Telescope find_files<CR>", { desc = "find file" })`,
+	), 0o644)
+	require.NoError(t, err)
+
+	keymaps, err := ParseDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: " ff", Action: "find file"},
+	}, keymaps)
+}
+
+func TestParseDir_DefaultsMapleaderToBackslash(t *testing.T) {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "keymaps.lua"), []byte(
+		`vim.keymap.set("n", "<leader>a", "append", {})`,
+	), 0o644)
+	require.NoError(t, err)
+
+	keymaps, err := ParseDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, []Keymap{
+		{Mode: "normal", Keybind: `\a`, Action: "append"},
+	}, keymaps)
+}
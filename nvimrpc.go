@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// msgpack-RPC message types
+// (https://github.com/msgpack-rpc/msgpack-rpc/blob/master/spec.md#message-type).
+const (
+	rpcMessageRequest  = 0
+	rpcMessageResponse = 1
+)
+
+// resolveNvimSocket picks the Neovim msgpack-RPC socket to send keys to:
+// --nvim-socket when given, otherwise $NVIM, the env var Neovim sets for
+// any process it spawns (e.g. a :terminal buffer), or "" when neither is
+// set, meaning 'x' has nothing to connect to.
+func resolveNvimSocket(flagValue string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	return os.Getenv("NVIM")
+}
+
+// keySequence builds the key sequence to send to nvim_input for keybind,
+// prefixed with whatever mode-switch gets a Neovim instance (in any
+// mode) into row.Mode first: <Esc> back to normal, then "i" or "v" to
+// re-enter insert/visual. Normal mode needs no extra prefix beyond the
+// <Esc>, which is a harmless no-op if already there. keybind keeps its
+// <...> termcode notation (e.g. "<C-h>", "<leader>x") -- nvim_input
+// parses it the same way a mapping's {lhs} would.
+func keySequence(mode, keybind string) string {
+	switch strings.ToLower(mode) {
+	case "insert":
+		return "<Esc>i" + keybind
+	case "visual":
+		return "<Esc>v" + keybind
+	default:
+		return "<Esc>" + keybind
+	}
+}
+
+// encodeInputRequest builds a msgpack-RPC request message calling
+// nvim_input(keys). Unlike nvim_feedkeys, nvim_input parses <...>
+// termcode notation itself, so keys can be handed over exactly as
+// stored in a rowConfig's Keybind.
+func encodeInputRequest(msgid uint32, keys string) ([]byte, error) {
+	req := []interface{}{rpcMessageRequest, msgid, "nvim_input", []interface{}{keys}}
+
+	data, err := msgpack.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("encoding nvim_input request: %w", err)
+	}
+	return data, nil
+}
+
+// decodeRPCResponse reads a msgpack-RPC response message
+// ([1, msgid, error, result]) from r and returns its error slot, nil on
+// success.
+func decodeRPCResponse(r io.Reader) (interface{}, error) {
+	var msg []interface{}
+	if err := msgpack.NewDecoder(r).Decode(&msg); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	if len(msg) != 4 {
+		return nil, fmt.Errorf("malformed response: expected 4 elements, got %d", len(msg))
+	}
+	return msg[2], nil
+}
+
+// sendKeys dials socket, sends a msgpack-RPC nvim_input request for keys,
+// and waits for the response, returning an error if Neovim reports one
+// or the connection/decode fails.
+func sendKeys(socket, keys string) error {
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", socket, err)
+	}
+	defer conn.Close()
+
+	req, err := encodeInputRequest(0, keys)
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.Write(req); err != nil {
+		return fmt.Errorf("writing request: %w", err)
+	}
+
+	rpcErr, err := decodeRPCResponse(conn)
+	if err != nil {
+		return err
+	}
+	if rpcErr != nil {
+		return fmt.Errorf("nvim_input failed: %v", rpcErr)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestResolveNvimSocket(t *testing.T) {
+	require.Equal(t, "/tmp/flag.sock", resolveNvimSocket("/tmp/flag.sock"))
+}
+
+func TestKeySequence(t *testing.T) {
+	cases := []struct {
+		mode    string
+		keybind string
+		want    string
+	}{
+		{"normal", "gd", "<Esc>gd"},
+		{"insert", "<C-h>", "<Esc>i<C-h>"},
+		{"visual", "y", "<Esc>vy"},
+		{"Insert", "<C-w>", "<Esc>i<C-w>"},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.want, keySequence(c.mode, c.keybind), "mode=%s keybind=%s", c.mode, c.keybind)
+	}
+}
+
+func TestEncodeInputRequest_PayloadShape(t *testing.T) {
+	data, err := encodeInputRequest(7, "<Esc>gd")
+	require.NoError(t, err)
+
+	var msg []interface{}
+	require.NoError(t, msgpack.Unmarshal(data, &msg))
+
+	require.Equal(t, []interface{}{
+		int8(rpcMessageRequest),
+		uint32(7),
+		"nvim_input",
+		[]interface{}{"<Esc>gd"},
+	}, msg)
+}
+
+func TestDecodeRPCResponse(t *testing.T) {
+	ok, err := msgpack.Marshal([]interface{}{rpcMessageResponse, 7, nil, true})
+	require.NoError(t, err)
+
+	errSlot, err := decodeRPCResponse(bytes.NewReader(ok))
+	require.NoError(t, err)
+	require.Nil(t, errSlot)
+
+	failed, err := msgpack.Marshal([]interface{}{rpcMessageResponse, 7, "invalid key", nil})
+	require.NoError(t, err)
+
+	errSlot, err = decodeRPCResponse(bytes.NewReader(failed))
+	require.NoError(t, err)
+	require.Equal(t, "invalid key", errSlot)
+}
+
+// fakeNvimSocket starts a one-shot unix-socket server that decodes a
+// single msgpack-RPC request and replies with resultErr as the response's
+// error slot (nil for success). It returns the socket path.
+func fakeNvimSocket(t *testing.T, onRequest func(method string, params []interface{}), resultErr interface{}) string {
+	t.Helper()
+
+	socket := filepath.Join(t.TempDir(), "nvim.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var req []interface{}
+		if err := msgpack.NewDecoder(conn).Decode(&req); err != nil {
+			return
+		}
+
+		if onRequest != nil && len(req) == 4 {
+			method, _ := req[2].(string)
+			params, _ := req[3].([]interface{})
+			onRequest(method, params)
+		}
+
+		msgid := req[1]
+		resp, _ := msgpack.Marshal([]interface{}{rpcMessageResponse, msgid, resultErr, true})
+		conn.Write(resp)
+	}()
+
+	return socket
+}
+
+// TestSendKeys_Success_CallsInputNotFeedkeysWithUntranslatedNotation pins
+// down the chunk0-6 review fix: nvim_feedkeys does not parse <...>
+// termcode notation, so feeding it a literal "<Esc>gd" types the six
+// characters '<','E','s','c','>','g','d' instead of pressing Escape.
+// nvim_input parses that notation itself, so the RPC call must be
+// nvim_input with the keybind passed through exactly as stored.
+func TestSendKeys_Success_CallsInputNotFeedkeysWithUntranslatedNotation(t *testing.T) {
+	var gotMethod string
+	var gotParams []interface{}
+
+	socket := fakeNvimSocket(t, func(method string, params []interface{}) {
+		gotMethod = method
+		gotParams = params
+	}, nil)
+
+	err := sendKeys(socket, "<Esc>gd")
+	require.NoError(t, err)
+
+	require.Equal(t, "nvim_input", gotMethod, "nvim_feedkeys would have left <Esc>gd untranslated")
+	require.Equal(t, []interface{}{"<Esc>gd"}, gotParams)
+}
+
+func TestSendKeys_NvimError(t *testing.T) {
+	socket := fakeNvimSocket(t, nil, "Invalid mode string")
+
+	err := sendKeys(socket, "<Esc>gd")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid mode string")
+}
+
+func TestSendKeys_NoListener(t *testing.T) {
+	err := sendKeys(filepath.Join(t.TempDir(), "missing.sock"), "<Esc>gd")
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// section is one Mode-grouped block of rows for the collapsible table
+// view: all the currently-filtered rows sharing a Mode, plus the index
+// into cfg.Rows each one came from (parallel to rows, same order).
+type section struct {
+	mode     string
+	rows     []table.Row
+	rowIndex []int
+}
+
+// groupRowsByMode buckets rows (with parallel cfg.Rows indices in
+// rowIndex) into sections ordered by modeOrder. A mode with no matching
+// rows is omitted entirely, so an active search or source filter that
+// empties out a whole mode hides its header along with its rows.
+func groupRowsByMode(modeOrder []string, rows []table.Row, rowIndex []int) []section {
+	byMode := map[string]*section{}
+
+	for i, row := range rows {
+		mode := row[0]
+
+		s, ok := byMode[mode]
+		if !ok {
+			s = &section{mode: mode}
+			byMode[mode] = s
+		}
+
+		s.rows = append(s.rows, row)
+		s.rowIndex = append(s.rowIndex, rowIndex[i])
+	}
+
+	sections := make([]section, 0, len(modeOrder))
+	for _, mode := range modeOrder {
+		if s, ok := byMode[mode]; ok {
+			sections = append(sections, *s)
+		}
+	}
+
+	return sections
+}
+
+// distinctModes returns the Mode values present in rows, in first-seen
+// order, mirroring distinctSources for the Source column. It fixes the
+// section order shown in the view regardless of how rows are filtered
+// or ranked afterwards.
+func distinctModes(rows []rowConfig) []string {
+	seen := map[string]bool{}
+	var modes []string
+	for _, row := range rows {
+		if seen[row.Mode] {
+			continue
+		}
+		seen[row.Mode] = true
+		modes = append(modes, row.Mode)
+	}
+	return modes
+}
+
+// titleCaseMode upper-cases the first rune of mode for display, e.g.
+// "normal" -> "Normal".
+func titleCaseMode(mode string) string {
+	if mode == "" {
+		return mode
+	}
+
+	r := []rune(mode)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// displayEntry is one line of the flat, cursor-navigable view of the
+// grouped table: either a section header (rowIdx -1, so 'zo'/'za' can
+// still target a collapsed section) or one visible row (rowIdx is its
+// index into cfg.Rows).
+type displayEntry struct {
+	mode   string
+	rowIdx int
+}
+
+// buildDisplayEntries flattens sections into cursor-navigable entries: a
+// header for every section (collapsed or not), followed by its rows when
+// expanded. Headers keep collapsed sections reachable by cursor so
+// 'zo'/'za' can reopen them.
+func buildDisplayEntries(sections []section, collapsed foldState) []displayEntry {
+	var entries []displayEntry
+	for _, sec := range sections {
+		entries = append(entries, displayEntry{mode: sec.mode, rowIdx: -1})
+		if collapsed.isCollapsed(sec.mode) {
+			continue
+		}
+		for _, idx := range sec.rowIndex {
+			entries = append(entries, displayEntry{mode: sec.mode, rowIdx: idx})
+		}
+	}
+	return entries
+}
+
+// foldState tracks which mode sections are collapsed (via 'zc'/'zM')
+// versus expanded (the default, or after 'zo'/'zR'). A mode absent from
+// the map is expanded; this mirrors Vim, where folds start open.
+type foldState map[string]bool
+
+func (f foldState) isCollapsed(mode string) bool {
+	return f[mode]
+}
+
+func (f foldState) close(mode string) {
+	f[mode] = true
+}
+
+func (f foldState) open(mode string) {
+	delete(f, mode)
+}
+
+func (f foldState) toggle(mode string) {
+	if f[mode] {
+		f.open(mode)
+	} else {
+		f.close(mode)
+	}
+}
+
+// closeAll collapses every mode in modes ('zM').
+func (f foldState) closeAll(modes []string) {
+	for _, mode := range modes {
+		f[mode] = true
+	}
+}
+
+// openAll expands every currently-collapsed mode ('zR').
+func (f foldState) openAll() {
+	for mode := range f {
+		delete(f, mode)
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupRowsByMode_OrdersSectionsByModeOrder(t *testing.T) {
+	rows := []table.Row{
+		{"insert", "<C-h>", "delete previous character"},
+		{"normal", "gd", "go to definition"},
+		{"visual", "y", "yank selection"},
+	}
+	rowIndex := []int{0, 1, 2}
+
+	sections := groupRowsByMode([]string{"visual", "insert", "normal"}, rows, rowIndex)
+
+	require.Len(t, sections, 3)
+	require.Equal(t, "visual", sections[0].mode)
+	require.Equal(t, "insert", sections[1].mode)
+	require.Equal(t, "normal", sections[2].mode)
+}
+
+func TestGroupRowsByMode_OmitsModeWithNoMatchingRows(t *testing.T) {
+	rows := []table.Row{
+		{"normal", "gd", "go to definition"},
+	}
+	rowIndex := []int{0}
+
+	sections := groupRowsByMode([]string{"visual", "insert", "normal"}, rows, rowIndex)
+
+	require.Len(t, sections, 1, "a filter that empties visual and insert should hide their headers too")
+	require.Equal(t, "normal", sections[0].mode)
+}
+
+func TestGroupRowsByMode_KeepsRowsAndIndicesParallel(t *testing.T) {
+	rows := []table.Row{
+		{"normal", "gd", "go to definition"},
+		{"normal", "K", "hover documentation"},
+	}
+	rowIndex := []int{5, 9}
+
+	sections := groupRowsByMode([]string{"normal"}, rows, rowIndex)
+
+	require.Len(t, sections, 1)
+	require.Equal(t, []table.Row{rows[0], rows[1]}, sections[0].rows)
+	require.Equal(t, []int{5, 9}, sections[0].rowIndex)
+}
+
+func TestDistinctModes_PreservesFirstSeenOrder(t *testing.T) {
+	rows := []rowConfig{
+		{Mode: "visual"},
+		{Mode: "insert"},
+		{Mode: "visual"},
+		{Mode: "normal"},
+	}
+
+	require.Equal(t, []string{"visual", "insert", "normal"}, distinctModes(rows))
+}
+
+func TestTitleCaseMode(t *testing.T) {
+	require.Equal(t, "Normal", titleCaseMode("normal"))
+	require.Equal(t, "", titleCaseMode(""))
+}
+
+func TestFoldState_CloseOpenToggle(t *testing.T) {
+	f := foldState{}
+
+	require.False(t, f.isCollapsed("normal"))
+
+	f.close("normal")
+	require.True(t, f.isCollapsed("normal"))
+
+	f.open("normal")
+	require.False(t, f.isCollapsed("normal"))
+
+	f.toggle("normal")
+	require.True(t, f.isCollapsed("normal"))
+
+	f.toggle("normal")
+	require.False(t, f.isCollapsed("normal"))
+}
+
+func TestFoldState_CloseAllThenOpenAll(t *testing.T) {
+	f := foldState{}
+	modes := []string{"visual", "insert", "normal"}
+
+	f.closeAll(modes)
+	for _, mode := range modes {
+		require.True(t, f.isCollapsed(mode), "%s should be collapsed", mode)
+	}
+
+	f.openAll()
+	for _, mode := range modes {
+		require.False(t, f.isCollapsed(mode), "%s should be expanded", mode)
+	}
+}
+
+func TestFoldState_OpenAllOnlyAffectsCollapsedModes(t *testing.T) {
+	f := foldState{"normal": true}
+
+	f.openAll()
+
+	require.Empty(t, f)
+}
+
+func TestBuildDisplayEntries_ExpandedSectionListsHeaderThenRows(t *testing.T) {
+	sections := []section{
+		{mode: "visual", rows: []table.Row{{"visual", "y", "yank"}}, rowIndex: []int{0}},
+	}
+
+	entries := buildDisplayEntries(sections, foldState{})
+
+	require.Equal(t, []displayEntry{
+		{mode: "visual", rowIdx: -1},
+		{mode: "visual", rowIdx: 0},
+	}, entries)
+}
+
+func TestBuildDisplayEntries_CollapsedSectionKeepsHeaderButOmitsRows(t *testing.T) {
+	sections := []section{
+		{mode: "visual", rows: []table.Row{{"visual", "y", "yank"}}, rowIndex: []int{0}},
+		{mode: "insert", rows: []table.Row{{"insert", "<C-h>", "del"}}, rowIndex: []int{1}},
+	}
+
+	entries := buildDisplayEntries(sections, foldState{"visual": true})
+
+	require.Equal(t, []displayEntry{
+		{mode: "visual", rowIdx: -1},
+		{mode: "insert", rowIdx: -1},
+		{mode: "insert", rowIdx: 1},
+	}, entries, "a collapsed section's header stays reachable by cursor so it can be reopened")
+}